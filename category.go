@@ -0,0 +1,48 @@
+package qbit
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// CreateCategory adds a new category, optionally with a save path override.
+func (q *Qbit) CreateCategory(name, savePath string) error {
+	return q.CreateCategoryContext(context.Background(), name, savePath)
+}
+
+// CreateCategoryContext adds a new category, optionally with a save path override.
+func (q *Qbit) CreateCategoryContext(ctx context.Context, name, savePath string) error {
+	values := url.Values{}
+	values.Set("category", name)
+	values.Set("savePath", savePath)
+
+	return q.postReq(ctx, "api/v2/torrents/createCategory", values, nil)
+}
+
+// EditCategory changes the save path of an existing category.
+func (q *Qbit) EditCategory(name, savePath string) error {
+	return q.EditCategoryContext(context.Background(), name, savePath)
+}
+
+// EditCategoryContext changes the save path of an existing category.
+func (q *Qbit) EditCategoryContext(ctx context.Context, name, savePath string) error {
+	values := url.Values{}
+	values.Set("category", name)
+	values.Set("savePath", savePath)
+
+	return q.postReq(ctx, "api/v2/torrents/editCategory", values, nil)
+}
+
+// RemoveCategories deletes 1 or more categories.
+func (q *Qbit) RemoveCategories(names ...string) error {
+	return q.RemoveCategoriesContext(context.Background(), names...)
+}
+
+// RemoveCategoriesContext deletes 1 or more categories.
+func (q *Qbit) RemoveCategoriesContext(ctx context.Context, names ...string) error {
+	values := url.Values{}
+	values.Set("categories", strings.Join(names, "\n"))
+
+	return q.postReq(ctx, "api/v2/torrents/removeCategories", values, nil)
+}
@@ -14,6 +14,7 @@ import (
 	"net/http/cookiejar"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"golang.org/x/net/publicsuffix"
@@ -26,7 +27,8 @@ const (
 
 // Custom errors returned by this package.
 var (
-	ErrLoginFailed = fmt.Errorf("authentication failed")
+	ErrLoginFailed      = fmt.Errorf("authentication failed")
+	ErrAddTorrentFailed = fmt.Errorf("adding torrent failed")
 )
 
 // Config is the input data needed to return a Qbit struct.
@@ -45,6 +47,16 @@ type Qbit struct {
 	config *Config
 	auth   string
 	client *http.Client
+
+	// loginMu serializes re-logins so concurrent requests that all hit a 403
+	// at once don't each stampede the login endpoint.
+	loginMu sync.Mutex
+
+	// sidMu guards sid, which is written by recordSID and read by SID() — both of
+	// which can run concurrently with an in-flight request on another goroutine.
+	sidMu sync.RWMutex
+	// sid is the last SID session cookie value observed after a successful login.
+	sid string
 }
 
 // Xfer is a transfer from the torrents/info endpoint.
@@ -177,9 +189,78 @@ func (q *Qbit) login(ctx context.Context) error {
 		return fmt.Errorf("%w: %s: %s: %s", ErrLoginFailed, resp.Status, req.URL, string(body))
 	}
 
+	q.recordSID()
+
 	return nil
 }
 
+// SID returns the session cookie value from the most recent successful login, or an
+// empty string if no login has succeeded yet. Useful for logging which session a
+// client is running under without exposing the whole cookie jar.
+func (q *Qbit) SID() string {
+	q.sidMu.RLock()
+	defer q.sidMu.RUnlock()
+
+	return q.sid
+}
+
+// recordSID saves the SID session cookie the login just established, so SID can report it.
+func (q *Qbit) recordSID() {
+	loginURL, err := url.Parse(q.config.URL)
+	if err != nil || q.client.Jar == nil {
+		return
+	}
+
+	for _, cookie := range q.client.Jar.Cookies(loginURL) {
+		if cookie.Name == "SID" {
+			q.sidMu.Lock()
+			q.sid = cookie.Value
+			q.sidMu.Unlock()
+
+			return
+		}
+	}
+}
+
+// relogin re-authenticates, holding loginMu so concurrent callers that all observed
+// a 403 at the same time serialize on a single login instead of racing the endpoint.
+func (q *Qbit) relogin(ctx context.Context) error {
+	q.loginMu.Lock()
+	defer q.loginMu.Unlock()
+
+	// A caller that held the lock before this one may already have logged in again.
+	if q.IsLoggedIn(ctx) {
+		return nil
+	}
+
+	return q.login(ctx)
+}
+
+// IsLoggedIn reports whether the current session is authenticated, by calling
+// api/v2/app/version, which requires a valid session but no special permissions.
+func (q *Qbit) IsLoggedIn(ctx context.Context) bool {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, q.config.URL+"api/v2/app/version", nil)
+	if err != nil {
+		return false
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	if q.auth != "" {
+		req.Header.Set("Authorization", q.auth)
+	}
+
+	resp, err := q.client.Do(req)
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return resp.StatusCode == http.StatusOK
+}
+
 // SetTorrentCategory updates the category for 1 or more torrents.
 func (q *Qbit) SetTorrentCategory(category string, torrentHashes ...string) error {
 	return q.SetTorrentCategoryContext(context.Background(), category, torrentHashes...)
@@ -191,12 +272,7 @@ func (q *Qbit) SetTorrentCategoryContext(ctx context.Context, category string, t
 	values.Set("category", category)
 	values.Set("hashes", strings.Join(torrentHashes, "|"))
 
-	var into map[string]interface{}
-	if err := q.postReq(ctx, "api/v2/torrents/setCategory", values, into); err != nil {
-		return err
-	}
-
-	return nil
+	return q.postReq(ctx, "api/v2/torrents/setCategory", values, nil)
 }
 
 // GetCategories returns all the categories in Qbit.
@@ -207,7 +283,7 @@ func (q *Qbit) GetCategories() (map[string]*Category, error) {
 // GetCategoriesContext returns all the categories in Qbit.
 func (q *Qbit) GetCategoriesContext(ctx context.Context) (map[string]*Category, error) {
 	cats := map[string]*Category{}
-	if err := q.getReq(ctx, "api/v2/torrents/categories", &cats); err != nil {
+	if err := q.getReq(ctx, "api/v2/torrents/categories", nil, &cats); err != nil {
 		return nil, err
 	}
 
@@ -221,23 +297,18 @@ func (q *Qbit) GetXfers() ([]*Xfer, error) {
 
 // GetXfersContext returns data about all transfers/downloads in the Qbit client.
 func (q *Qbit) GetXfersContext(ctx context.Context) ([]*Xfer, error) {
-	xfers := []*Xfer{}
-	if err := q.getReq(ctx, "api/v2/torrents/info", &xfers); err != nil {
-		return nil, err
-	}
-
-	return xfers, nil
+	return q.GetXfersFilteredContext(ctx, &XferOptions{Filter: "all"})
 }
 
-func (q *Qbit) getReq(ctx context.Context, path string, into interface{}) error {
-	return q.req(ctx, http.MethodGet, q.config.URL+path, nil, into, true)
+func (q *Qbit) getReq(ctx context.Context, path string, values url.Values, into interface{}) error {
+	return q.req(ctx, http.MethodGet, q.config.URL+path, values, into, true)
 }
 
 func (q *Qbit) postReq(ctx context.Context, path string, values url.Values, into interface{}) error {
 	return q.req(ctx, http.MethodPost, q.config.URL+path, values, into, true)
 }
 
-func (q *Qbit) req(ctx context.Context, method, uri string, val url.Values, into interface{}, loop bool) error {
+func (q *Qbit) req(ctx context.Context, method, uri string, val url.Values, into interface{}, retryOn403 bool) error {
 	var body io.Reader
 
 	if method == http.MethodPost {
@@ -252,7 +323,6 @@ func (q *Qbit) req(ctx context.Context, method, uri string, val url.Values, into
 	if method == http.MethodPost {
 		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	} else {
-		val.Set("filter", "all")
 		req.URL.RawQuery = val.Encode()
 	}
 
@@ -268,15 +338,34 @@ func (q *Qbit) req(ctx context.Context, method, uri string, val url.Values, into
 	}
 	defer resp.Body.Close()
 
-	if err := json.NewDecoder(resp.Body).Decode(into); err != nil {
-		if err := q.login(ctx); err != nil {
+	// qBittorrent returns 403 Forbidden once the SID cookie has expired, regardless
+	// of the endpoint. Re-login once and retry rather than misreading it as a decode error.
+	if resp.StatusCode == http.StatusForbidden && retryOn403 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+
+		if err := q.relogin(ctx); err != nil {
 			return err
 		}
 
-		if loop { // try again after logging in.
-			return q.req(ctx, method, uri, val, into, false)
-		}
+		return q.req(ctx, method, uri, val, into, false)
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading %s response: %w", method, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s %s: %s", method, resp.Status, respBody)
+	}
+
+	// Most action endpoints (pause, resume, setCategory, ...) return no body on success;
+	// callers that don't need a result pass a nil into and there's nothing to decode.
+	if into == nil {
+		return nil
+	}
 
+	if err := json.Unmarshal(respBody, into); err != nil {
 		return fmt.Errorf("%s: %w", resp.Status, err)
 	}
 
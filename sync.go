@@ -0,0 +1,219 @@
+package qbit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ServerState is the global transfer/session info included in MainData.
+type ServerState struct {
+	AlltimeDl            int64  `json:"alltime_dl"`
+	AlltimeUl            int64  `json:"alltime_ul"`
+	AverageTimeQueue     int64  `json:"average_time_queue"`
+	ConnectionStatus     string `json:"connection_status"`
+	DhtNodes             int    `json:"dht_nodes"`
+	DlInfoData           int64  `json:"dl_info_data"`
+	DlInfoSpeed          int64  `json:"dl_info_speed"`
+	DlRateLimit          int64  `json:"dl_rate_limit"`
+	FreeSpaceOnDisk      int64  `json:"free_space_on_disk"`
+	GlobalRatio          string `json:"global_ratio"`
+	QueuedIoJobs         int64  `json:"queued_io_jobs"`
+	Queueing             bool   `json:"queueing"`
+	ReadCacheHits        string `json:"read_cache_hits"`
+	ReadCacheOverload    string `json:"read_cache_overload"`
+	RefreshInterval      int64  `json:"refresh_interval"`
+	TotalBuffersSize     int64  `json:"total_buffers_size"`
+	TotalPeerConnections int    `json:"total_peer_connections"`
+	TotalQueuedSize      int64  `json:"total_queued_size"`
+	TotalWastedSession   int64  `json:"total_wasted_session"`
+	UpInfoData           int64  `json:"up_info_data"`
+	UpInfoSpeed          int64  `json:"up_info_speed"`
+	UpRateLimit          int64  `json:"up_rate_limit"`
+	UseAltSpeedLimits    bool   `json:"use_alt_speed_limits"`
+	WriteCacheOverload   string `json:"write_cache_overload"`
+}
+
+// MainData is the delta (or, when FullUpdate is true, full) payload from api/v2/sync/maindata.
+// Torrents holds raw per-torrent JSON so callers can merge a delta onto a previously
+// decoded *Xfer without clobbering fields the delta didn't include.
+type MainData struct {
+	Rid               int                        `json:"rid"`
+	FullUpdate        bool                       `json:"full_update"`
+	Torrents          map[string]json.RawMessage `json:"torrents"`
+	TorrentsRemoved   []string                   `json:"torrents_removed"`
+	Categories        map[string]*Category       `json:"categories"`
+	CategoriesRemoved []string                   `json:"categories_removed"`
+	Tags              []string                   `json:"tags"`
+	TagsRemoved       []string                   `json:"tags_removed"`
+	ServerState       *ServerState               `json:"server_state"`
+}
+
+// PeersSync is the delta (or, when FullUpdate is true, full) payload from api/v2/sync/torrentPeers.
+type PeersSync struct {
+	FullUpdate   bool            `json:"full_update"`
+	Rid          int             `json:"rid"`
+	ShowFlags    bool            `json:"show_flags"`
+	Peers        map[string]Peer `json:"peers"`
+	PeersRemoved []string        `json:"peers_removed"`
+}
+
+// SyncMainData returns the full torrent/category/tag state when rid is 0, or only what
+// changed since rid otherwise. Keep using the Rid from the response for subsequent calls.
+func (q *Qbit) SyncMainData(ctx context.Context, rid int) (*MainData, error) {
+	values := url.Values{}
+	values.Set("rid", strconv.Itoa(rid))
+
+	data := &MainData{}
+	if err := q.getReq(ctx, "api/v2/sync/maindata", values, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// SyncTorrentPeers returns the full peer list for a torrent when rid is 0, or only what
+// changed since rid otherwise. Keep using the Rid from the response for subsequent calls.
+func (q *Qbit) SyncTorrentPeers(ctx context.Context, hash string, rid int) (*PeersSync, error) {
+	values := url.Values{}
+	values.Set("hash", hash)
+	values.Set("rid", strconv.Itoa(rid))
+
+	peers := &PeersSync{}
+	if err := q.getReq(ctx, "api/v2/sync/torrentPeers", values, peers); err != nil {
+		return nil, err
+	}
+
+	return peers, nil
+}
+
+// EventType identifies what happened to a torrent in a MainDataEvent.
+type EventType string
+
+// Event types emitted on the Watch channel.
+const (
+	EventAdded   EventType = "added"
+	EventUpdated EventType = "updated"
+	EventRemoved EventType = "removed"
+)
+
+// MainDataEvent describes a single torrent state change observed by Watch.
+// Xfer is nil when Type is EventRemoved.
+type MainDataEvent struct {
+	Type EventType
+	Hash string
+	Xfer *Xfer
+}
+
+// Watch polls SyncMainData on the given interval and emits a typed event per torrent
+// that was added, updated, or removed since the last poll. The channel is closed when
+// ctx is canceled. Errors encountered while polling are swallowed and retried on the
+// next tick, since a single failed poll shouldn't stop the watch.
+func (q *Qbit) Watch(ctx context.Context, interval time.Duration) (<-chan MainDataEvent, error) {
+	initial, err := q.SyncMainData(ctx, 0)
+	if err != nil {
+		return nil, fmt.Errorf("initial sync: %w", err)
+	}
+
+	events := make(chan MainDataEvent)
+
+	go q.watch(ctx, interval, initial, events)
+
+	return events, nil
+}
+
+// watch runs the Watch poll loop. It owns state and closes events when ctx is done.
+func (q *Qbit) watch(ctx context.Context, interval time.Duration, initial *MainData, events chan<- MainDataEvent) {
+	defer close(events)
+
+	state := map[string]*Xfer{}
+	rid := initial.Rid
+
+	if !q.applyMainData(ctx, initial, state, events) {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			delta, err := q.SyncMainData(ctx, rid)
+			if err != nil {
+				continue
+			}
+
+			rid = delta.Rid
+
+			if !q.applyMainData(ctx, delta, state, events) {
+				return
+			}
+		}
+	}
+}
+
+// applyMainData merges one MainData payload into state and emits the resulting events.
+// It returns false if the context was canceled while sending an event.
+func (q *Qbit) applyMainData(
+	ctx context.Context, data *MainData, state map[string]*Xfer, events chan<- MainDataEvent,
+) bool {
+	// A full update lists every torrent and no removals, so anything cached but absent is gone.
+	if data.FullUpdate {
+		for hash := range state {
+			if _, ok := data.Torrents[hash]; !ok {
+				data.TorrentsRemoved = append(data.TorrentsRemoved, hash)
+			}
+		}
+	}
+
+	for _, hash := range data.TorrentsRemoved {
+		delete(state, hash)
+
+		if !sendEvent(ctx, events, MainDataEvent{Type: EventRemoved, Hash: hash}) {
+			return false
+		}
+	}
+
+	for hash, raw := range data.Torrents {
+		xfer, existed := state[hash]
+		if xfer == nil {
+			xfer = &Xfer{}
+		}
+
+		if err := json.Unmarshal(raw, xfer); err != nil {
+			continue
+		}
+
+		state[hash] = xfer
+
+		eventType := EventUpdated
+		if !existed {
+			eventType = EventAdded
+		}
+
+		// Send a copy so the next poll's merge into state doesn't race with the receiver.
+		snapshot := *xfer
+
+		if !sendEvent(ctx, events, MainDataEvent{Type: eventType, Hash: hash, Xfer: &snapshot}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sendEvent delivers an event unless ctx is canceled first. It reports whether the send succeeded.
+func sendEvent(ctx context.Context, events chan<- MainDataEvent, event MainDataEvent) bool {
+	select {
+	case events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
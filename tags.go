@@ -0,0 +1,92 @@
+package qbit
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// TagList parses the comma-separated Tags field into a slice.
+func (x *Xfer) TagList() []string {
+	if x.Tags == "" {
+		return nil
+	}
+
+	tags := strings.Split(x.Tags, ",")
+	for idx, tag := range tags {
+		tags[idx] = strings.TrimSpace(tag)
+	}
+
+	return tags
+}
+
+// GetTags returns all the tags known to Qbit.
+func (q *Qbit) GetTags() ([]string, error) {
+	return q.GetTagsContext(context.Background())
+}
+
+// GetTagsContext returns all the tags known to Qbit.
+func (q *Qbit) GetTagsContext(ctx context.Context) ([]string, error) {
+	tags := []string{}
+	if err := q.getReq(ctx, "api/v2/torrents/tags", nil, &tags); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// CreateTags creates 1 or more tags.
+func (q *Qbit) CreateTags(tags ...string) error {
+	return q.CreateTagsContext(context.Background(), tags...)
+}
+
+// CreateTagsContext creates 1 or more tags.
+func (q *Qbit) CreateTagsContext(ctx context.Context, tags ...string) error {
+	values := url.Values{}
+	values.Set("tags", strings.Join(tags, ","))
+
+	return q.postReq(ctx, "api/v2/torrents/createTags", values, nil)
+}
+
+// DeleteTags removes 1 or more tags.
+func (q *Qbit) DeleteTags(tags ...string) error {
+	return q.DeleteTagsContext(context.Background(), tags...)
+}
+
+// DeleteTagsContext removes 1 or more tags.
+func (q *Qbit) DeleteTagsContext(ctx context.Context, tags ...string) error {
+	values := url.Values{}
+	values.Set("tags", strings.Join(tags, ","))
+
+	return q.postReq(ctx, "api/v2/torrents/deleteTags", values, nil)
+}
+
+// AddTorrentTags adds 1 or more tags to 1 or more torrents.
+func (q *Qbit) AddTorrentTags(tags []string, hashes ...string) error {
+	return q.AddTorrentTagsContext(context.Background(), tags, hashes...)
+}
+
+// AddTorrentTagsContext adds 1 or more tags to 1 or more torrents.
+func (q *Qbit) AddTorrentTagsContext(ctx context.Context, tags []string, hashes ...string) error {
+	values := url.Values{}
+	values.Set("hashes", strings.Join(hashes, "|"))
+	values.Set("tags", strings.Join(tags, ","))
+
+	return q.postReq(ctx, "api/v2/torrents/addTags", values, nil)
+}
+
+// RemoveTorrentTags removes 1 or more tags from 1 or more torrents.
+// Pass no tags to remove all tags from the given torrents.
+func (q *Qbit) RemoveTorrentTags(tags []string, hashes ...string) error {
+	return q.RemoveTorrentTagsContext(context.Background(), tags, hashes...)
+}
+
+// RemoveTorrentTagsContext removes 1 or more tags from 1 or more torrents.
+// Pass no tags to remove all tags from the given torrents.
+func (q *Qbit) RemoveTorrentTagsContext(ctx context.Context, tags []string, hashes ...string) error {
+	values := url.Values{}
+	values.Set("hashes", strings.Join(hashes, "|"))
+	values.Set("tags", strings.Join(tags, ","))
+
+	return q.postReq(ctx, "api/v2/torrents/removeTags", values, nil)
+}
@@ -0,0 +1,175 @@
+package qbit
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// PauseTorrents pauses 1 or more torrents.
+func (q *Qbit) PauseTorrents(hashes ...string) error {
+	return q.PauseTorrentsContext(context.Background(), hashes...)
+}
+
+// PauseTorrentsContext pauses 1 or more torrents.
+func (q *Qbit) PauseTorrentsContext(ctx context.Context, hashes ...string) error {
+	values := url.Values{}
+	values.Set("hashes", strings.Join(hashes, "|"))
+
+	return q.postReq(ctx, "api/v2/torrents/pause", values, nil)
+}
+
+// ResumeTorrents resumes 1 or more torrents.
+func (q *Qbit) ResumeTorrents(hashes ...string) error {
+	return q.ResumeTorrentsContext(context.Background(), hashes...)
+}
+
+// ResumeTorrentsContext resumes 1 or more torrents.
+func (q *Qbit) ResumeTorrentsContext(ctx context.Context, hashes ...string) error {
+	values := url.Values{}
+	values.Set("hashes", strings.Join(hashes, "|"))
+
+	return q.postReq(ctx, "api/v2/torrents/resume", values, nil)
+}
+
+// DeleteTorrents removes 1 or more torrents, optionally deleting their files from disk.
+func (q *Qbit) DeleteTorrents(deleteFiles bool, hashes ...string) error {
+	return q.DeleteTorrentsContext(context.Background(), deleteFiles, hashes...)
+}
+
+// DeleteTorrentsContext removes 1 or more torrents, optionally deleting their files from disk.
+func (q *Qbit) DeleteTorrentsContext(ctx context.Context, deleteFiles bool, hashes ...string) error {
+	values := url.Values{}
+	values.Set("hashes", strings.Join(hashes, "|"))
+	values.Set("deleteFiles", strconv.FormatBool(deleteFiles))
+
+	return q.postReq(ctx, "api/v2/torrents/delete", values, nil)
+}
+
+// RecheckTorrents forces a hash recheck on 1 or more torrents.
+func (q *Qbit) RecheckTorrents(hashes ...string) error {
+	return q.RecheckTorrentsContext(context.Background(), hashes...)
+}
+
+// RecheckTorrentsContext forces a hash recheck on 1 or more torrents.
+func (q *Qbit) RecheckTorrentsContext(ctx context.Context, hashes ...string) error {
+	values := url.Values{}
+	values.Set("hashes", strings.Join(hashes, "|"))
+
+	return q.postReq(ctx, "api/v2/torrents/recheck", values, nil)
+}
+
+// ReannounceTorrents forces 1 or more torrents to reannounce to their trackers.
+func (q *Qbit) ReannounceTorrents(hashes ...string) error {
+	return q.ReannounceTorrentsContext(context.Background(), hashes...)
+}
+
+// ReannounceTorrentsContext forces 1 or more torrents to reannounce to their trackers.
+func (q *Qbit) ReannounceTorrentsContext(ctx context.Context, hashes ...string) error {
+	values := url.Values{}
+	values.Set("hashes", strings.Join(hashes, "|"))
+
+	return q.postReq(ctx, "api/v2/torrents/reannounce", values, nil)
+}
+
+// SetLocation moves 1 or more torrents to a new save path.
+func (q *Qbit) SetLocation(path string, hashes ...string) error {
+	return q.SetLocationContext(context.Background(), path, hashes...)
+}
+
+// SetLocationContext moves 1 or more torrents to a new save path.
+func (q *Qbit) SetLocationContext(ctx context.Context, path string, hashes ...string) error {
+	values := url.Values{}
+	values.Set("location", path)
+	values.Set("hashes", strings.Join(hashes, "|"))
+
+	return q.postReq(ctx, "api/v2/torrents/setLocation", values, nil)
+}
+
+// RenameTorrent sets the display name of a single torrent.
+func (q *Qbit) RenameTorrent(hash, name string) error {
+	return q.RenameTorrentContext(context.Background(), hash, name)
+}
+
+// RenameTorrentContext sets the display name of a single torrent.
+func (q *Qbit) RenameTorrentContext(ctx context.Context, hash, name string) error {
+	values := url.Values{}
+	values.Set("hash", hash)
+	values.Set("name", name)
+
+	return q.postReq(ctx, "api/v2/torrents/rename", values, nil)
+}
+
+// SetUploadLimit sets the upload speed limit, in bytes/second, for 1 or more torrents.
+func (q *Qbit) SetUploadLimit(limit int, hashes ...string) error {
+	return q.SetUploadLimitContext(context.Background(), limit, hashes...)
+}
+
+// SetUploadLimitContext sets the upload speed limit, in bytes/second, for 1 or more torrents.
+func (q *Qbit) SetUploadLimitContext(ctx context.Context, limit int, hashes ...string) error {
+	values := url.Values{}
+	values.Set("hashes", strings.Join(hashes, "|"))
+	values.Set("limit", strconv.Itoa(limit))
+
+	return q.postReq(ctx, "api/v2/torrents/setUploadLimit", values, nil)
+}
+
+// SetDownloadLimit sets the download speed limit, in bytes/second, for 1 or more torrents.
+func (q *Qbit) SetDownloadLimit(limit int, hashes ...string) error {
+	return q.SetDownloadLimitContext(context.Background(), limit, hashes...)
+}
+
+// SetDownloadLimitContext sets the download speed limit, in bytes/second, for 1 or more torrents.
+func (q *Qbit) SetDownloadLimitContext(ctx context.Context, limit int, hashes ...string) error {
+	values := url.Values{}
+	values.Set("hashes", strings.Join(hashes, "|"))
+	values.Set("limit", strconv.Itoa(limit))
+
+	return q.postReq(ctx, "api/v2/torrents/setDownloadLimit", values, nil)
+}
+
+// SetShareLimits sets the ratio and seeding time limits, in minutes, for 1 or more torrents.
+// Use -1 for either value to mean "no limit", or -2 to use the global limit.
+func (q *Qbit) SetShareLimits(ratio float64, seedingTimeMin int, hashes ...string) error {
+	return q.SetShareLimitsContext(context.Background(), ratio, seedingTimeMin, hashes...)
+}
+
+// SetShareLimitsContext sets the ratio and seeding time limits, in minutes, for 1 or more torrents.
+// Use -1 for either value to mean "no limit", or -2 to use the global limit.
+func (q *Qbit) SetShareLimitsContext(ctx context.Context, ratio float64, seedingTimeMin int, hashes ...string) error {
+	values := url.Values{}
+	values.Set("hashes", strings.Join(hashes, "|"))
+	values.Set("ratioLimit", strconv.FormatFloat(ratio, 'f', -1, 64))
+	values.Set("seedingTimeLimit", strconv.Itoa(seedingTimeMin))
+
+	return q.postReq(ctx, "api/v2/torrents/setShareLimits", values, nil)
+}
+
+// SetForceStart enables or disables force start for 1 or more torrents.
+func (q *Qbit) SetForceStart(enable bool, hashes ...string) error {
+	return q.SetForceStartContext(context.Background(), enable, hashes...)
+}
+
+// SetForceStartContext enables or disables force start for 1 or more torrents.
+func (q *Qbit) SetForceStartContext(ctx context.Context, enable bool, hashes ...string) error {
+	values := url.Values{}
+	values.Set("hashes", strings.Join(hashes, "|"))
+	values.Set("value", strconv.FormatBool(enable))
+
+	return q.postReq(ctx, "api/v2/torrents/setForceStart", values, nil)
+}
+
+// SetSuperSeeding enables or disables super seeding for 1 or more torrents.
+func (q *Qbit) SetSuperSeeding(enable bool, hashes ...string) error {
+	return q.SetSuperSeedingContext(context.Background(), enable, hashes...)
+}
+
+// SetSuperSeedingContext enables or disables super seeding for 1 or more torrents.
+func (q *Qbit) SetSuperSeedingContext(ctx context.Context, enable bool, hashes ...string) error {
+	values := url.Values{}
+	values.Set("hashes", strings.Join(hashes, "|"))
+	values.Set("value", strconv.FormatBool(enable))
+
+	return q.postReq(ctx, "api/v2/torrents/setSuperSeeding", values, nil)
+}
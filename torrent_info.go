@@ -0,0 +1,250 @@
+package qbit
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Tracker is a single tracker entry from a torrent's tracker list.
+type Tracker struct {
+	URL           string `json:"url"`
+	Status        int    `json:"status"`
+	Tier          int    `json:"tier"`
+	NumPeers      int    `json:"num_peers"`
+	NumSeeds      int    `json:"num_seeds"`
+	NumLeeches    int    `json:"num_leeches"`
+	NumDownloaded int    `json:"num_downloaded"`
+	Msg           string `json:"msg"`
+}
+
+// Peer is a single remote peer reported by Qbit for a torrent.
+type Peer struct {
+	Client       string  `json:"client"`
+	Connection   string  `json:"connection"`
+	Country      string  `json:"country"`
+	CountryCode  string  `json:"country_code"`
+	DlSpeed      int64   `json:"dl_speed"`
+	Downloaded   int64   `json:"downloaded"`
+	Flags        string  `json:"flags"`
+	FlagsDesc    string  `json:"flags_desc"`
+	IP           string  `json:"ip"`
+	PeerIDClient string  `json:"peer_id_client"`
+	Port         int     `json:"port"`
+	Progress     float64 `json:"progress"`
+	Relevance    float64 `json:"relevance"`
+	UpSpeed      int64   `json:"up_speed"`
+	Uploaded     int64   `json:"uploaded"`
+}
+
+// TorrentFile is a single file within a torrent from api/v2/torrents/files.
+type TorrentFile struct {
+	Index        int     `json:"index"`
+	Name         string  `json:"name"`
+	Size         int64   `json:"size"`
+	Progress     float64 `json:"progress"`
+	Priority     int     `json:"priority"`
+	IsSeed       bool    `json:"is_seed"`
+	PieceRange   []int   `json:"piece_range"`
+	Availability float64 `json:"availability"`
+}
+
+// TorrentProperties is the generic info returned by api/v2/torrents/properties.
+type TorrentProperties struct {
+	SavePath               string  `json:"save_path"`
+	CreationDate           int64   `json:"creation_date"`
+	PieceSize              int64   `json:"piece_size"`
+	Comment                string  `json:"comment"`
+	TotalWasted            int64   `json:"total_wasted"`
+	TotalUploaded          int64   `json:"total_uploaded"`
+	TotalUploadedSession   int64   `json:"total_uploaded_session"`
+	TotalDownloaded        int64   `json:"total_downloaded"`
+	TotalDownloadedSession int64   `json:"total_downloaded_session"`
+	UpLimit                int64   `json:"up_limit"`
+	DlLimit                int64   `json:"dl_limit"`
+	TimeElapsed            int64   `json:"time_elapsed"`
+	SeedingTime            int64   `json:"seeding_time"`
+	NbConnections          int     `json:"nb_connections"`
+	NbConnectionsLimit     int     `json:"nb_connections_limit"`
+	ShareRatio             float64 `json:"share_ratio"`
+	AdditionDate           int64   `json:"addition_date"`
+	CompletionDate         int64   `json:"completion_date"`
+	CreatedBy              string  `json:"created_by"`
+	DlSpeedAvg             int64   `json:"dl_speed_avg"`
+	DlSpeed                int64   `json:"dl_speed"`
+	Eta                    int64   `json:"eta"`
+	LastSeen               int64   `json:"last_seen"`
+	Peers                  int     `json:"peers"`
+	PeersTotal             int     `json:"peers_total"`
+	PiecesHave             int     `json:"pieces_have"`
+	PiecesNum              int     `json:"pieces_num"`
+	Reannounce             int64   `json:"reannounce"`
+	Seeds                  int     `json:"seeds"`
+	SeedsTotal             int     `json:"seeds_total"`
+	TotalSize              int64   `json:"total_size"`
+	UpSpeedAvg             int64   `json:"up_speed_avg"`
+	UpSpeed                int64   `json:"up_speed"`
+}
+
+// GetTorrentTrackers returns the trackers configured on a torrent.
+func (q *Qbit) GetTorrentTrackers(hash string) ([]*Tracker, error) {
+	return q.GetTorrentTrackersContext(context.Background(), hash)
+}
+
+// GetTorrentTrackersContext returns the trackers configured on a torrent.
+func (q *Qbit) GetTorrentTrackersContext(ctx context.Context, hash string) ([]*Tracker, error) {
+	values := url.Values{}
+	values.Set("hash", hash)
+
+	trackers := []*Tracker{}
+	if err := q.getReq(ctx, "api/v2/torrents/trackers", values, &trackers); err != nil {
+		return nil, err
+	}
+
+	return trackers, nil
+}
+
+// GetTorrentPeers returns the current snapshot of peers connected for a torrent.
+// It's a thin wrapper around SyncTorrentPeers with rid 0, for callers that just want
+// the current state and don't care about delta polling.
+func (q *Qbit) GetTorrentPeers(hash string) (*PeersSync, error) {
+	return q.GetTorrentPeersContext(context.Background(), hash)
+}
+
+// GetTorrentPeersContext returns the current snapshot of peers connected for a torrent.
+// It's a thin wrapper around SyncTorrentPeers with rid 0, for callers that just want
+// the current state and don't care about delta polling.
+func (q *Qbit) GetTorrentPeersContext(ctx context.Context, hash string) (*PeersSync, error) {
+	return q.SyncTorrentPeers(ctx, hash, 0)
+}
+
+// GetTorrentFiles returns the files that make up a torrent.
+func (q *Qbit) GetTorrentFiles(hash string) ([]*TorrentFile, error) {
+	return q.GetTorrentFilesContext(context.Background(), hash)
+}
+
+// GetTorrentFilesContext returns the files that make up a torrent.
+func (q *Qbit) GetTorrentFilesContext(ctx context.Context, hash string) ([]*TorrentFile, error) {
+	values := url.Values{}
+	values.Set("hash", hash)
+
+	files := []*TorrentFile{}
+	if err := q.getReq(ctx, "api/v2/torrents/files", values, &files); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// GetTorrentPieceStates returns the download state (0 not downloaded, 1 downloading, 2 have) of each piece.
+func (q *Qbit) GetTorrentPieceStates(hash string) ([]int, error) {
+	return q.GetTorrentPieceStatesContext(context.Background(), hash)
+}
+
+// GetTorrentPieceStatesContext returns the download state (0 not downloaded, 1 downloading, 2 have) of each piece.
+func (q *Qbit) GetTorrentPieceStatesContext(ctx context.Context, hash string) ([]int, error) {
+	values := url.Values{}
+	values.Set("hash", hash)
+
+	states := []int{}
+	if err := q.getReq(ctx, "api/v2/torrents/pieceStates", values, &states); err != nil {
+		return nil, err
+	}
+
+	return states, nil
+}
+
+// GetTorrentPieceHashes returns the SHA-1 hash of each piece in a torrent.
+func (q *Qbit) GetTorrentPieceHashes(hash string) ([]string, error) {
+	return q.GetTorrentPieceHashesContext(context.Background(), hash)
+}
+
+// GetTorrentPieceHashesContext returns the SHA-1 hash of each piece in a torrent.
+func (q *Qbit) GetTorrentPieceHashesContext(ctx context.Context, hash string) ([]string, error) {
+	values := url.Values{}
+	values.Set("hash", hash)
+
+	hashes := []string{}
+	if err := q.getReq(ctx, "api/v2/torrents/pieceHashes", values, &hashes); err != nil {
+		return nil, err
+	}
+
+	return hashes, nil
+}
+
+// GetTorrentProperties returns the generic properties of a torrent.
+func (q *Qbit) GetTorrentProperties(hash string) (*TorrentProperties, error) {
+	return q.GetTorrentPropertiesContext(context.Background(), hash)
+}
+
+// GetTorrentPropertiesContext returns the generic properties of a torrent.
+func (q *Qbit) GetTorrentPropertiesContext(ctx context.Context, hash string) (*TorrentProperties, error) {
+	values := url.Values{}
+	values.Set("hash", hash)
+
+	props := &TorrentProperties{}
+	if err := q.getReq(ctx, "api/v2/torrents/properties", values, props); err != nil {
+		return nil, err
+	}
+
+	return props, nil
+}
+
+// AddTrackers adds 1 or more trackers to a torrent.
+func (q *Qbit) AddTrackers(hash string, urls ...string) error {
+	return q.AddTrackersContext(context.Background(), hash, urls...)
+}
+
+// AddTrackersContext adds 1 or more trackers to a torrent.
+func (q *Qbit) AddTrackersContext(ctx context.Context, hash string, urls ...string) error {
+	values := url.Values{}
+	values.Set("hash", hash)
+	values.Set("urls", strings.Join(urls, "\n"))
+
+	return q.postReq(ctx, "api/v2/torrents/addTrackers", values, nil)
+}
+
+// EditTracker replaces a tracker URL on a torrent with a new one.
+func (q *Qbit) EditTracker(hash, origURL, newURL string) error {
+	return q.EditTrackerContext(context.Background(), hash, origURL, newURL)
+}
+
+// EditTrackerContext replaces a tracker URL on a torrent with a new one.
+func (q *Qbit) EditTrackerContext(ctx context.Context, hash, origURL, newURL string) error {
+	values := url.Values{}
+	values.Set("hash", hash)
+	values.Set("origUrl", origURL)
+	values.Set("newUrl", newURL)
+
+	return q.postReq(ctx, "api/v2/torrents/editTracker", values, nil)
+}
+
+// RemoveTrackers removes 1 or more trackers from a torrent.
+func (q *Qbit) RemoveTrackers(hash string, urls ...string) error {
+	return q.RemoveTrackersContext(context.Background(), hash, urls...)
+}
+
+// RemoveTrackersContext removes 1 or more trackers from a torrent.
+func (q *Qbit) RemoveTrackersContext(ctx context.Context, hash string, urls ...string) error {
+	values := url.Values{}
+	values.Set("hash", hash)
+	values.Set("urls", strings.Join(urls, "|"))
+
+	return q.postReq(ctx, "api/v2/torrents/removeTrackers", values, nil)
+}
+
+// SetFilePriority sets the download priority for 1 or more files in a torrent.
+func (q *Qbit) SetFilePriority(hash string, priority int, fileIDs ...string) error {
+	return q.SetFilePriorityContext(context.Background(), hash, priority, fileIDs...)
+}
+
+// SetFilePriorityContext sets the download priority for 1 or more files in a torrent.
+func (q *Qbit) SetFilePriorityContext(ctx context.Context, hash string, priority int, fileIDs ...string) error {
+	values := url.Values{}
+	values.Set("hash", hash)
+	values.Set("id", strings.Join(fileIDs, "|"))
+	values.Set("priority", strconv.Itoa(priority))
+
+	return q.postReq(ctx, "api/v2/torrents/filePrio", values, nil)
+}
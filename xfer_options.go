@@ -0,0 +1,81 @@
+package qbit
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// XferOptions filters, sorts, and paginates the results of GetXfersFiltered.
+// All fields are optional; the zero value matches every torrent, same as GetXfers.
+type XferOptions struct {
+	Filter   string
+	Category string
+	Tag      string
+	Sort     string
+	Reverse  bool
+	Limit    int
+	Offset   int
+	Hashes   []string
+}
+
+// values builds the api/v2/torrents/info query string for these options.
+func (o *XferOptions) values() url.Values {
+	values := url.Values{}
+
+	if o == nil {
+		return values
+	}
+
+	if o.Filter != "" {
+		values.Set("filter", o.Filter)
+	}
+
+	if o.Category != "" {
+		values.Set("category", o.Category)
+	}
+
+	if o.Tag != "" {
+		values.Set("tag", o.Tag)
+	}
+
+	if o.Sort != "" {
+		values.Set("sort", o.Sort)
+	}
+
+	if o.Reverse {
+		values.Set("reverse", "true")
+	}
+
+	if o.Limit > 0 {
+		values.Set("limit", strconv.Itoa(o.Limit))
+	}
+
+	if o.Offset > 0 {
+		values.Set("offset", strconv.Itoa(o.Offset))
+	}
+
+	if len(o.Hashes) > 0 {
+		values.Set("hashes", strings.Join(o.Hashes, "|"))
+	}
+
+	return values
+}
+
+// GetXfersFiltered returns data about transfers/downloads matching opts, letting the
+// Qbit server do the filtering, sorting, and pagination instead of fetching every torrent.
+func (q *Qbit) GetXfersFiltered(opts *XferOptions) ([]*Xfer, error) {
+	return q.GetXfersFilteredContext(context.Background(), opts)
+}
+
+// GetXfersFilteredContext returns data about transfers/downloads matching opts, letting the
+// Qbit server do the filtering, sorting, and pagination instead of fetching every torrent.
+func (q *Qbit) GetXfersFilteredContext(ctx context.Context, opts *XferOptions) ([]*Xfer, error) {
+	xfers := []*Xfer{}
+	if err := q.getReq(ctx, "api/v2/torrents/info", opts.values(), &xfers); err != nil {
+		return nil, err
+	}
+
+	return xfers, nil
+}